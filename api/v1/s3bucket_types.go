@@ -0,0 +1,404 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VersioningSpec configures S3 bucket versioning.
+type VersioningSpec struct {
+	// Enabled turns on bucket versioning. When false, versioning is left in its
+	// current state (S3 does not support reverting to Unversioned).
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Suspended suspends versioning on a previously-versioned bucket.
+	// +optional
+	Suspended bool `json:"suspended,omitempty"`
+
+	// MFADelete enables MFA delete protection on object versions.
+	// +optional
+	MFADelete bool `json:"mfaDelete,omitempty"`
+}
+
+// ServerSideEncryptionSpec configures default bucket encryption.
+type ServerSideEncryptionSpec struct {
+	// SSEAlgorithm is either "AES256" (SSE-S3) or "aws:kms" (SSE-KMS).
+	// +kubebuilder:validation:Enum=AES256;aws:kms
+	SSEAlgorithm string `json:"sseAlgorithm"`
+
+	// KMSMasterKeyID is the KMS key ID or ARN used when SSEAlgorithm is "aws:kms".
+	// +optional
+	KMSMasterKeyID string `json:"kmsMasterKeyID,omitempty"`
+
+	// BucketKeyEnabled reduces KMS request costs by using an S3 Bucket Key.
+	// +optional
+	BucketKeyEnabled bool `json:"bucketKeyEnabled,omitempty"`
+}
+
+// CORSRule mirrors a single s3.CORSRule.
+type CORSRule struct {
+	ID             string   `json:"id,omitempty"`
+	AllowedMethods []string `json:"allowedMethods"`
+	AllowedOrigins []string `json:"allowedOrigins"`
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+	ExposeHeaders  []string `json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds  int64    `json:"maxAgeSeconds,omitempty"`
+}
+
+// LifecycleFilter selects the objects a lifecycle rule applies to.
+type LifecycleFilter struct {
+	// Prefix restricts the rule to keys starting with this prefix.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Tags restricts the rule to objects carrying all of these tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// LifecycleTransition moves objects to another storage class after Days.
+type LifecycleTransition struct {
+	Days         int64  `json:"days"`
+	StorageClass string `json:"storageClass"`
+}
+
+// LifecycleRule mirrors a single s3.LifecycleRule.
+type LifecycleRule struct {
+	ID     string          `json:"id,omitempty"`
+	Status string          `json:"status"`
+	Filter LifecycleFilter `json:"filter,omitempty"`
+
+	// +optional
+	Transitions []LifecycleTransition `json:"transitions,omitempty"`
+
+	// ExpirationDays expires current object versions after this many days.
+	// +optional
+	ExpirationDays int64 `json:"expirationDays,omitempty"`
+
+	// AbortIncompleteMultipartUploadDays aborts stale multipart uploads.
+	// +optional
+	AbortIncompleteMultipartUploadDays int64 `json:"abortIncompleteMultipartUploadDays,omitempty"`
+}
+
+// WebsiteSpec configures static website hosting on the bucket.
+type WebsiteSpec struct {
+	// IndexDocument is the suffix appended to requests for "directories" (e.g. "index.html").
+	// +optional
+	IndexDocument string `json:"indexDocument,omitempty"`
+
+	// ErrorDocument is the key returned when a request results in an error.
+	// +optional
+	ErrorDocument string `json:"errorDocument,omitempty"`
+
+	// RedirectAllRequestsTo redirects every request to another host.
+	// +optional
+	RedirectAllRequestsTo string `json:"redirectAllRequestsTo,omitempty"`
+}
+
+// LoggingSpec configures server access logging for the bucket.
+type LoggingSpec struct {
+	// TargetBucket receives the access log objects.
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetPrefix is prepended to every delivered log object key.
+	// +optional
+	TargetPrefix string `json:"targetPrefix,omitempty"`
+}
+
+// ProviderProfile identifies the S3-compatible backend a bucket is hosted on,
+// so the reconciler knows which S3 API operations it can safely attempt.
+// +kubebuilder:validation:Enum=AWS;MinIO;CephRGW;Garage;Generic
+type ProviderProfile string
+
+const (
+	// ProviderAWS is Amazon S3 itself and supports the full API surface.
+	ProviderAWS ProviderProfile = "AWS"
+
+	// ProviderMinIO is a self-hosted MinIO cluster.
+	ProviderMinIO ProviderProfile = "MinIO"
+
+	// ProviderCephRGW is Ceph RADOS Gateway's S3-compatible endpoint.
+	ProviderCephRGW ProviderProfile = "CephRGW"
+
+	// ProviderGarage is a Garage cluster. Older Garage builds do not
+	// implement CreateBucket/DeleteBucket over the S3 API.
+	ProviderGarage ProviderProfile = "Garage"
+
+	// ProviderGeneric is any other S3-compatible endpoint of unknown
+	// capability; the reconciler assumes the conservative common subset.
+	ProviderGeneric ProviderProfile = "Generic"
+)
+
+// IRSACredentials obtains temporary credentials for a pod's Kubernetes
+// ServiceAccount via a projected OIDC token and AssumeRoleWithWebIdentity,
+// following the IAM Roles for Service Accounts (IRSA) pattern.
+type IRSACredentials struct {
+	// RoleARN is the IAM role the service account is allowed to assume.
+	RoleARN string `json:"roleARN"`
+
+	// TokenFilePath is where the projected service account token is mounted.
+	// Defaults to the path the EKS pod-identity-webhook injects.
+	// +optional
+	TokenFilePath string `json:"tokenFilePath,omitempty"`
+
+	// SessionName is used as the STS RoleSessionName. Defaults to the bucket name.
+	// +optional
+	SessionName string `json:"sessionName,omitempty"`
+}
+
+// InstanceRoleCredentials uses the AWS SDK's default credential chain, i.e.
+// whatever EC2 instance profile or ECS task role the operator pod is running as.
+type InstanceRoleCredentials struct{}
+
+// AssumeRoleCredentials layers an STS AssumeRole on top of whichever
+// credentials are otherwise resolved for the bucket (static, IRSA, or the
+// instance role).
+type AssumeRoleCredentials struct {
+	// RoleARN is the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is passed to sts:AssumeRole, typically required by
+	// cross-account roles to guard against the confused deputy problem.
+	// +optional
+	ExternalID string `json:"externalID,omitempty"`
+
+	// SessionName is used as the STS RoleSessionName. Defaults to the bucket name.
+	// +optional
+	SessionName string `json:"sessionName,omitempty"`
+}
+
+// CredentialSource is a discriminated union selecting how the reconciler
+// authenticates to AWS (or an S3-compatible backend). Exactly one of IRSA or
+// InstanceRole may be set as the base identity; AssumeRole may additionally
+// be set to chain an STS AssumeRole on top of that base identity (or, if
+// neither IRSA nor InstanceRole is set, on top of the legacy static secret).
+type CredentialSource struct {
+	// IRSA authenticates via a projected service account token.
+	// +optional
+	IRSA *IRSACredentials `json:"irsa,omitempty"`
+
+	// InstanceRole authenticates via the EC2/ECS instance role.
+	// +optional
+	InstanceRole *InstanceRoleCredentials `json:"instanceRole,omitempty"`
+
+	// AssumeRole chains an STS AssumeRole on top of the base identity.
+	// +optional
+	AssumeRole *AssumeRoleCredentials `json:"assumeRole,omitempty"`
+}
+
+// DeletionPolicy controls what handleDelete does to the live bucket when its
+// S3Bucket CR is deleted.
+// +kubebuilder:validation:Enum=Retain;Fail;Empty
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain removes the finalizer without touching the bucket.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+
+	// DeletionPolicyFail calls DeleteBucket as-is, which AWS rejects for any
+	// non-empty bucket. This is the default, matching the historical behavior.
+	DeletionPolicyFail DeletionPolicy = "Fail"
+
+	// DeletionPolicyEmpty deletes every object version and delete marker
+	// before calling DeleteBucket.
+	DeletionPolicyEmpty DeletionPolicy = "Empty"
+)
+
+// S3BucketSpec defines the desired state of S3Bucket
+type S3BucketSpec struct {
+	// BucketName is the name of the bucket to manage.
+	BucketName string `json:"bucketName"`
+
+	// Region is the AWS region the bucket lives in.
+	Region string `json:"region"`
+
+	// AWSCredsSecretRef points at a Secret holding aws_access_key_id/aws_secret_access_key.
+	AWSCredsSecretRef corev1.SecretReference `json:"awsCredsSecretRef"`
+
+	// Endpoint overrides the default AWS S3 endpoint, for S3-compatible
+	// backends such as MinIO, Ceph RGW, Garage, or DigitalOcean Spaces.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// S3ForcePathStyle addresses buckets as "endpoint/bucket" instead of
+	// "bucket.endpoint", which most self-hosted S3-compatible backends require.
+	// +optional
+	S3ForcePathStyle bool `json:"s3ForcePathStyle,omitempty"`
+
+	// DisableSSL talks to Endpoint over plain HTTP. Only ever use this against
+	// a trusted network.
+	// +optional
+	DisableSSL bool `json:"disableSSL,omitempty"`
+
+	// CABundleSecretRef points at a Secret whose "ca.crt" key is trusted in
+	// addition to the system roots, for self-signed TLS on Endpoint.
+	// +optional
+	CABundleSecretRef *corev1.SecretReference `json:"caBundleSecretRef,omitempty"`
+
+	// ProviderProfile identifies the S3-compatible backend in use and gates
+	// which operations the reconciler will attempt against it. Defaults to
+	// ProviderAWS when Endpoint is unset, and to ProviderGeneric otherwise.
+	// +optional
+	ProviderProfile ProviderProfile `json:"providerProfile,omitempty"`
+
+	// CredentialSource selects how the reconciler authenticates requests.
+	// When unset, it falls back to the legacy static-secret mode driven by
+	// AWSCredsSecretRef.
+	// +optional
+	CredentialSource *CredentialSource `json:"credentialSource,omitempty"`
+
+	// AdoptExisting, when true, makes handleCreate HeadBucket first and adopt
+	// a pre-existing bucket it owns instead of calling CreateBucket.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
+
+	// ReconcileInterval, when set, causes the reconciler to periodically
+	// re-verify bucket existence and re-apply any configuration drift on top
+	// of reacting to spec changes. Unset disables periodic drift reconciliation.
+	// +optional
+	ReconcileInterval metav1.Duration `json:"reconcileInterval,omitempty"`
+
+	// DeletionPolicy controls how handleDelete treats a non-empty bucket.
+	// Defaults to Fail, matching the historical behavior.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Versioning configures bucket versioning.
+	// +optional
+	Versioning *VersioningSpec `json:"versioning,omitempty"`
+
+	// ServerSideEncryption configures default bucket encryption.
+	// +optional
+	ServerSideEncryption *ServerSideEncryptionSpec `json:"serverSideEncryption,omitempty"`
+
+	// ACL is a canned ACL applied to the bucket (e.g. "private", "public-read").
+	// +optional
+	ACL string `json:"acl,omitempty"`
+
+	// Policy is the bucket policy document as a JSON string.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+
+	// CORSRules configures cross-origin resource sharing.
+	// +optional
+	CORSRules []CORSRule `json:"corsRules,omitempty"`
+
+	// LifecycleRules configures object lifecycle transitions and expiration.
+	// +optional
+	LifecycleRules []LifecycleRule `json:"lifecycleRules,omitempty"`
+
+	// Tags are applied to the bucket itself.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Website configures static website hosting.
+	// +optional
+	Website *WebsiteSpec `json:"website,omitempty"`
+
+	// Logging configures server access logging.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+}
+
+// SubsystemCondition reports the reconciliation status of one bucket subsystem
+// (versioning, encryption, policy, etc).
+type SubsystemCondition struct {
+	// Subsystem names the configuration area this condition describes, e.g. "Versioning".
+	Subsystem string `json:"subsystem"`
+
+	// Status is the subsystem's last observed status, e.g. "InSync", "Failed".
+	Status string `json:"status"`
+
+	// Message is a human-readable detail, typically the last error.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is when Status last changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// Typed condition types reported on S3BucketStatus.Conditions, so users can
+// e.g. `kubectl wait --for=condition=Ready s3bucket/foo`.
+const (
+	// ConditionTypeReady is True once the bucket exists and its last
+	// reconcile, create, or drift check completed successfully.
+	ConditionTypeReady = "Ready"
+
+	// ConditionTypeReconciling is True while handleUpdate is applying the
+	// configured sub-specs. Create and delete do not set it.
+	ConditionTypeReconciling = "Reconciling"
+
+	// ConditionTypeCredentialsValid is True once the configured
+	// CredentialSource (or the AWSCredsSecretRef secret) has been resolved
+	// into usable AWS credentials.
+	ConditionTypeCredentialsValid = "CredentialsValid"
+
+	// ConditionTypeDriftDetected is True when the most recent periodic drift
+	// check failed to bring one or more subsystems in line with Spec. Drift
+	// that the check corrected is not reported; the condition is then False.
+	ConditionTypeDriftDetected = "DriftDetected"
+)
+
+// S3BucketStatus defines the observed state of S3Bucket
+type S3BucketStatus struct {
+	// SubsystemConditions reports per-subsystem reconciliation status from handleUpdate.
+	// +optional
+	SubsystemConditions []SubsystemCondition `json:"subsystemConditions,omitempty"`
+
+	// Conditions report the bucket's Ready/Reconciling/CredentialsValid/DriftDetected state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// S3Bucket is the Schema for the s3buckets API
+type S3Bucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   S3BucketSpec   `json:"spec,omitempty"`
+	Status S3BucketStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// S3BucketList contains a list of S3Bucket
+type S3BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []S3Bucket `json:"items"`
+}
+
+// NewPatch returns a patch representing the object's state when it was loaded,
+// so callers can mutate it and Patch() only the fields that changed.
+func (in *S3Bucket) NewPatch() client.Patch {
+	return client.MergeFrom(in.DeepCopy())
+}
+
+func init() {
+	SchemeBuilder.Register(&S3Bucket{}, &S3BucketList{})
+}
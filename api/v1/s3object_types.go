@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BucketReference points at an S3Bucket, optionally in another namespace.
+type BucketReference struct {
+	// Name of the referenced S3Bucket.
+	Name string `json:"name"`
+
+	// Namespace of the referenced S3Bucket. Defaults to the S3Object's own namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SecretDataSource reads object content from a key of a Secret.
+type SecretDataSource struct {
+	// Name of the Secret.
+	Name string `json:"name"`
+
+	// Namespace of the Secret. Defaults to the S3Object's own namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key within the Secret's data to use as the object content.
+	Key string `json:"key"`
+}
+
+// ConfigMapDataSource reads object content from a key of a ConfigMap.
+type ConfigMapDataSource struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap. Defaults to the S3Object's own namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key within the ConfigMap's data (or binaryData) to use as the object content.
+	Key string `json:"key"`
+}
+
+// S3ObjectSpec defines the desired state of S3Object. Exactly one of
+// InlineData, SecretRef, ConfigMapRef, or SourceURL must be set.
+type S3ObjectSpec struct {
+	// BucketRef is the S3Bucket this object is materialized into.
+	BucketRef BucketReference `json:"bucketRef"`
+
+	// Key is the object key within the bucket.
+	Key string `json:"key"`
+
+	// InlineData is the object content given directly in the spec.
+	// +optional
+	InlineData []byte `json:"inlineData,omitempty"`
+
+	// SecretRef reads the object content from a Secret key.
+	// +optional
+	SecretRef *SecretDataSource `json:"secretRef,omitempty"`
+
+	// ConfigMapRef reads the object content from a ConfigMap key.
+	// +optional
+	ConfigMapRef *ConfigMapDataSource `json:"configMapRef,omitempty"`
+
+	// SourceURL fetches the object content from an HTTP(S) URL at reconcile time.
+	// +optional
+	SourceURL string `json:"sourceURL,omitempty"`
+
+	// ContentType sets the object's Content-Type header.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// CacheControl sets the object's Cache-Control header.
+	// +optional
+	CacheControl string `json:"cacheControl,omitempty"`
+
+	// Metadata is stored as user metadata (x-amz-meta-*) on the object.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// StorageClass is the S3 storage class to store the object under.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// SSE configures server-side encryption for this object, overriding the bucket default.
+	// +optional
+	SSE *ServerSideEncryptionSpec `json:"sse,omitempty"`
+
+	// Tags are applied to the object.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// S3ObjectStatus defines the observed state of S3Object
+type S3ObjectStatus struct {
+	// SHA256 is the hex-encoded SHA-256 of the content last written to the
+	// object, mirroring the x-amz-meta-kubes3-sha256 metadata stored on it.
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// S3Object is the Schema for the s3objects API
+type S3Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   S3ObjectSpec   `json:"spec,omitempty"`
+	Status S3ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// S3ObjectList contains a list of S3Object
+type S3ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []S3Object `json:"items"`
+}
+
+// NewPatch returns a patch representing the object's state when it was loaded,
+// so callers can mutate it and Patch() only the fields that changed.
+func (in *S3Object) NewPatch() client.Patch {
+	return client.MergeFrom(in.DeepCopy())
+}
+
+func init() {
+	SchemeBuilder.Register(&S3Object{}, &S3ObjectList{})
+}
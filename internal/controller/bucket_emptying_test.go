@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// fakeVersionsS3 is a minimal s3iface.S3API stub covering just the two calls
+// emptyBucketPage makes, so its pagination math can be tested without a real
+// S3 endpoint. Embedding the interface satisfies every other method with a
+// nil panic, which is fine as long as the test never exercises them.
+type fakeVersionsS3 struct {
+	s3iface.S3API
+
+	versions      []*s3.ObjectVersion
+	deleteMarkers []*s3.DeleteMarkerEntry
+	listErr       error
+	deleted       []*s3.ObjectIdentifier
+}
+
+func (f *fakeVersionsS3) ListObjectVersions(in *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+
+	return &s3.ListObjectVersionsOutput{Versions: f.versions, DeleteMarkers: f.deleteMarkers}, nil
+}
+
+func (f *fakeVersionsS3) DeleteObjects(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	f.deleted = in.Delete.Objects
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func TestEmptyBucketPage(t *testing.T) {
+	t.Run("already empty reports drained with nothing deleted", func(t *testing.T) {
+		fake := &fakeVersionsS3{}
+
+		drained, deletedThisPass, err := emptyBucketPage(fake, "my-bucket")
+		if err != nil {
+			t.Fatalf("emptyBucketPage() error = %v", err)
+		}
+		if !drained {
+			t.Error("drained = false, want true")
+		}
+		if deletedThisPass != 0 {
+			t.Errorf("deletedThisPass = %d, want 0", deletedThisPass)
+		}
+	})
+
+	t.Run("a batch of versions and delete markers is deleted in one pass", func(t *testing.T) {
+		fake := &fakeVersionsS3{
+			versions:      []*s3.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("v1")}},
+			deleteMarkers: []*s3.DeleteMarkerEntry{{Key: aws.String("b"), VersionId: aws.String("v2")}},
+		}
+
+		drained, deletedThisPass, err := emptyBucketPage(fake, "my-bucket")
+		if err != nil {
+			t.Fatalf("emptyBucketPage() error = %v", err)
+		}
+		if drained {
+			t.Error("drained = true, want false (a batch was just deleted, not yet re-listed)")
+		}
+		if deletedThisPass != 2 {
+			t.Errorf("deletedThisPass = %d, want 2", deletedThisPass)
+		}
+		if len(fake.deleted) != 2 {
+			t.Errorf("DeleteObjects called with %d objects, want 2", len(fake.deleted))
+		}
+	})
+
+	t.Run("ListObjectVersions error is returned without deleting", func(t *testing.T) {
+		fake := &fakeVersionsS3{listErr: errors.New("boom")}
+
+		drained, deletedThisPass, err := emptyBucketPage(fake, "my-bucket")
+		if err == nil {
+			t.Fatal("emptyBucketPage() error = nil, want error")
+		}
+		if drained {
+			t.Error("drained = true, want false")
+		}
+		if deletedThisPass != 0 {
+			t.Errorf("deletedThisPass = %d, want 0", deletedThisPass)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestCanonicalizeJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "key order and whitespace differences canonicalize the same",
+			raw:  `{  "Version": "2012-10-17", "Statement": []  }`,
+			want: `{"Statement":[],"Version":"2012-10-17"}`,
+		},
+		{
+			name:    "invalid JSON errors",
+			raw:     `{not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalizeJSON(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("canonicalizeJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("canonicalizeJSON() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeJSONIgnoresKeyOrder(t *testing.T) {
+	a, err := canonicalizeJSON(`{"a": 1, "b": 2}`)
+	if err != nil {
+		t.Fatalf("canonicalizeJSON() error = %v", err)
+	}
+
+	b, err := canonicalizeJSON(`{"b": 2, "a": 1}`)
+	if err != nil {
+		t.Fatalf("canonicalizeJSON() error = %v", err)
+	}
+
+	if a != b {
+		t.Errorf("canonicalizeJSON() not order-independent: %q != %q", a, b)
+	}
+}
+
+func granteeByID(id, permission string) *s3.Grant {
+	return &s3.Grant{Grantee: &s3.Grantee{ID: aws.String(id)}, Permission: aws.String(permission)}
+}
+
+func granteeByURI(uri, permission string) *s3.Grant {
+	return &s3.Grant{Grantee: &s3.Grantee{URI: aws.String(uri)}, Permission: aws.String(permission)}
+}
+
+func TestACLMatches(t *testing.T) {
+	const ownerID = "owner-id"
+
+	tests := []struct {
+		name   string
+		acl    string
+		grants []*s3.Grant
+		want   bool
+	}{
+		{
+			name:   "private matches owner-only grant",
+			acl:    "private",
+			grants: []*s3.Grant{granteeByID(ownerID, "FULL_CONTROL")},
+			want:   true,
+		},
+		{
+			name: "public-read matches owner plus AllUsers read",
+			acl:  "public-read",
+			grants: []*s3.Grant{
+				granteeByID(ownerID, "FULL_CONTROL"),
+				granteeByURI(allUsersGranteeURI, "READ"),
+			},
+			want: true,
+		},
+		{
+			name: "private does not match when AllUsers has read",
+			acl:  "private",
+			grants: []*s3.Grant{
+				granteeByID(ownerID, "FULL_CONTROL"),
+				granteeByURI(allUsersGranteeURI, "READ"),
+			},
+			want: false,
+		},
+		{
+			name:   "unrecognized canned ACL never matches",
+			acl:    "log-delivery-write",
+			grants: []*s3.Grant{granteeByID(ownerID, "FULL_CONTROL")},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &s3.GetBucketAclOutput{
+				Owner:  &s3.Owner{ID: aws.String(ownerID)},
+				Grants: tt.grants,
+			}
+
+			if got := aclMatches(current, tt.acl); got != tt.want {
+				t.Errorf("aclMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
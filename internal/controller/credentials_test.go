@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/testisnullus/KubeS3/api/v1"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add corev1 to scheme: %v", err)
+	}
+	if err := awsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add awsv1 to scheme: %v", err)
+	}
+
+	return scheme
+}
+
+func newStaticCredsSecret() *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"aws_access_key_id":     []byte("AKIAEXAMPLE"),
+			"aws_secret_access_key": []byte("example-secret"),
+		},
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	identitySess := session.Must(session.NewSession())
+
+	t.Run("nil CredentialSource falls back to the static secret", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(newStaticCredsSecret()).Build()
+		b := &awsv1.S3Bucket{Spec: awsv1.S3BucketSpec{
+			BucketName:        "my-bucket",
+			AWSCredsSecretRef: v1.SecretReference{Name: "creds", Namespace: "default"},
+		}}
+
+		creds, err := resolveCredentials(context.Background(), c, identitySess, b)
+		if err != nil {
+			t.Fatalf("resolveCredentials() error = %v", err)
+		}
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("creds.Get() error = %v", err)
+		}
+		if v.AccessKeyID != "AKIAEXAMPLE" {
+			t.Errorf("AccessKeyID = %q, want %q", v.AccessKeyID, "AKIAEXAMPLE")
+		}
+	})
+
+	t.Run("InstanceRole leaves credentials unset for the SDK's default chain", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+		b := &awsv1.S3Bucket{Spec: awsv1.S3BucketSpec{
+			BucketName:       "my-bucket",
+			CredentialSource: &awsv1.CredentialSource{InstanceRole: &awsv1.InstanceRoleCredentials{}},
+		}}
+
+		creds, err := resolveCredentials(context.Background(), c, identitySess, b)
+		if err != nil {
+			t.Fatalf("resolveCredentials() error = %v", err)
+		}
+		if creds != nil {
+			t.Errorf("creds = %v, want nil", creds)
+		}
+	})
+
+	t.Run("AssumeRole with no base identity chains on top of the static secret", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(newStaticCredsSecret()).Build()
+		b := &awsv1.S3Bucket{Spec: awsv1.S3BucketSpec{
+			BucketName:        "my-bucket",
+			AWSCredsSecretRef: v1.SecretReference{Name: "creds", Namespace: "default"},
+			CredentialSource: &awsv1.CredentialSource{
+				AssumeRole: &awsv1.AssumeRoleCredentials{RoleARN: "arn:aws:iam::123456789012:role/example"},
+			},
+		}}
+
+		creds, err := resolveCredentials(context.Background(), c, identitySess, b)
+		if err != nil {
+			t.Fatalf("resolveCredentials() error = %v", err)
+		}
+		if creds == nil {
+			t.Fatal("creds = nil, want non-nil AssumeRole-wrapped credentials")
+		}
+	})
+
+	t.Run("missing secret surfaces an error", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+		b := &awsv1.S3Bucket{Spec: awsv1.S3BucketSpec{
+			BucketName:        "my-bucket",
+			AWSCredsSecretRef: v1.SecretReference{Name: "missing", Namespace: "default"},
+		}}
+
+		if _, err := resolveCredentials(context.Background(), c, identitySess, b); err == nil {
+			t.Fatal("resolveCredentials() error = nil, want error for missing secret")
+		}
+	})
+}
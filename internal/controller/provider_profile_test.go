@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	awsv1 "github.com/testisnullus/KubeS3/api/v1"
+)
+
+func TestCapabilitiesFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    awsv1.ProviderProfile
+		wantCreate bool
+		wantDelete bool
+	}{
+		{name: "defaults to AWS when unset", profile: "", wantCreate: true, wantDelete: true},
+		{name: "AWS", profile: awsv1.ProviderAWS, wantCreate: true, wantDelete: true},
+		{name: "MinIO", profile: awsv1.ProviderMinIO, wantCreate: true, wantDelete: true},
+		{name: "CephRGW", profile: awsv1.ProviderCephRGW, wantCreate: true, wantDelete: true},
+		{name: "Garage", profile: awsv1.ProviderGarage, wantCreate: false, wantDelete: false},
+		{name: "Generic", profile: awsv1.ProviderGeneric, wantCreate: false, wantDelete: false},
+		{name: "unknown profile falls back to Generic", profile: "Unknown", wantCreate: false, wantDelete: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &awsv1.S3Bucket{Spec: awsv1.S3BucketSpec{ProviderProfile: tt.profile}}
+
+			got := capabilitiesFor(b)
+			if got.SupportsCreateBucket != tt.wantCreate {
+				t.Errorf("SupportsCreateBucket = %v, want %v", got.SupportsCreateBucket, tt.wantCreate)
+			}
+			if got.SupportsDeleteBucket != tt.wantDelete {
+				t.Errorf("SupportsDeleteBucket = %v, want %v", got.SupportsDeleteBucket, tt.wantDelete)
+			}
+		})
+	}
+}
@@ -18,18 +18,26 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
 
 	awsv1 "github.com/testisnullus/KubeS3/api/v1"
+	"github.com/testisnullus/KubeS3/internal/metrics"
 	"github.com/testisnullus/KubeS3/internal/models"
 	"github.com/testisnullus/KubeS3/internal/ratelimiter"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,6 +50,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// emptyBucketRequeueDelay paces successive emptyBucketPage batches while
+// DeletionPolicyEmpty is draining a bucket. It is a plain RequeueAfter, not
+// an error, so "still emptying" progress doesn't trip the failure rate
+// limiter and back off to DefaultMaxDelay over a long-running deletion.
+const emptyBucketRequeueDelay = 5 * time.Second
+
 // S3BucketReconciler reconciles a S3Bucket object
 type S3BucketReconciler struct {
 	client.Client
@@ -62,11 +76,11 @@ type S3BucketReconciler struct {
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.4/pkg/reconcile
-func (r *S3BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *S3BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, err error) {
 	l := log.FromContext(ctx)
 
 	b := &awsv1.S3Bucket{}
-	err := r.Client.Get(ctx, req.NamespacedName, b)
+	err = r.Client.Get(ctx, req.NamespacedName, b)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			l.Info("S3 bucket resource is not found",
@@ -79,14 +93,28 @@ func (r *S3BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
-	switch b.Annotations[models.StateAnnotation] {
+	event := b.Annotations[models.StateAnnotation]
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		metrics.ReconcileTotal.WithLabelValues(result, event).Inc()
+	}()
+
+	switch event {
 	case models.CreatingEvent:
 		l = l.WithName("S3 Bucket creation")
 		return r.handleCreate(ctx, &l, b)
-	// TODO: add update event handling
+	case models.UpdatingEvent:
+		l = l.WithName("S3 Bucket update")
+		return r.handleUpdate(ctx, &l, b)
 	case models.DeletingEvent:
 		l = l.WithName("S3 Bucket deleting")
 		return r.handleDelete(ctx, &l, b)
+	case models.CreatedEvent, models.UpdatedEvent:
+		l = l.WithName("S3 Bucket drift check")
+		return r.handleDriftCheck(ctx, &l, b)
 	case models.GenericEvent:
 		l = l.WithName("S3 Bucket generic")
 		l.Info("Event isn't handled",
@@ -106,63 +134,225 @@ func (r *S3BucketReconciler) handleCreate(ctx context.Context, l *logr.Logger, b
 		"region", b.Spec.Region,
 	)
 
-	s, err := r.handleSecret(ctx, b)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
+	if !capabilitiesFor(b).SupportsCreateBucket {
+		l.Info("Provider profile does not support CreateBucket, assuming bucket already exists",
+			"providerProfile", b.Spec.ProviderProfile, "bucket", b.Spec.BucketName)
 
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(b.Spec.Region),
-		Credentials: credentials.NewStaticCredentialsFromCreds(credentials.Value{
-			AccessKeyID:     string(s.Data["aws_access_key_id"]),
-			SecretAccessKey: string(s.Data["aws_secret_access_key"]),
-		}),
-	},
-	)
+		patch := b.NewPatch()
+		controllerutil.AddFinalizer(b, models.DeletionFinalizer)
+		b.Annotations[models.StateAnnotation] = models.CreatedEvent
+		if err := r.Patch(ctx, b, patch); err != nil {
+			l.Error(err, "Unable to patch S3 bucket with deletion finalizer", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
+
+		statusPatch := b.NewPatch()
+		recordReadyTransition(b, false)
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionTrue, "UnsupportedByProvider", "provider profile does not support CreateBucket, assuming bucket already exists")
+		metrics.BucketsManaged.WithLabelValues(b.Spec.Region).Inc()
+		if err := r.Status().Patch(ctx, b, statusPatch); err != nil {
+			l.Error(err, "Unable to patch S3 bucket status conditions", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
 
+		return ctrl.Result{}, nil
+	}
+
+	svc, err := buildS3Client(ctx, r.Client, b)
 	if err != nil {
-		l.Error(err, "Unable to create AWS session", "spec", b.Spec)
+		l.Error(err, "Unable to build S3 client", "spec", b.Spec)
 		return ctrl.Result{}, err
 	}
 
-	// Create S3 service client
-	svc := s3.New(sess)
-	_, err = svc.CreateBucket(&s3.CreateBucketInput{
-		Bucket: aws.String(b.Spec.BucketName),
+	if b.Spec.AdoptExisting {
+		err := metrics.TimedS3Call("HeadBucket", func() error {
+			_, err := svc.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(b.Spec.BucketName)})
+			return err
+		})
+		if err == nil {
+			l.Info("Adopting pre-existing bucket", "bucket", b.Spec.BucketName)
+			return r.markCreated(ctx, l, b)
+		}
+	}
+
+	err = metrics.TimedS3Call("CreateBucket", func() error {
+		_, err := svc.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
 	})
 	if err != nil {
-		l.Error(err, "Unable to create S3 Bucket", "bucket", b.Spec)
-		return ctrl.Result{}, err
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "BucketAlreadyOwnedByYou" {
+			l.Info("Bucket already owned by us, treating as created", "bucket", b.Spec.BucketName)
+		} else {
+			l.Error(err, "Unable to create S3 Bucket", "bucket", b.Spec)
+			return r.markCreateFailed(ctx, l, b, err)
+		}
 	}
 
 	// Wait until bucket is created before finishing
 	l.Info("Waiting for bucket to be created...", "spec", b.Spec)
 
-	err = svc.WaitUntilBucketExists(&s3.HeadBucketInput{
-		Bucket: aws.String(b.Spec.BucketName),
+	err = metrics.TimedS3Call("WaitUntilBucketExists", func() error {
+		return svc.WaitUntilBucketExists(&s3.HeadBucketInput{Bucket: aws.String(b.Spec.BucketName)})
 	})
 	if err != nil {
 		l.Error(err, "Unable to wait for bucket to be created", "spec", b.Spec)
-		return ctrl.Result{}, err
+		return r.markCreateFailed(ctx, l, b, err)
 	}
 
+	return r.markCreated(ctx, l, b)
+}
+
+// markCreateFailed records a Ready=False condition and updates BucketsInError
+// before returning the triggering error, so handleCreate's failure paths
+// surface on kubectl wait and in metrics the same way a successful create
+// surfaces via markCreated.
+func (r *S3BucketReconciler) markCreateFailed(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket, createErr error) (ctrl.Result, error) {
+	patch := b.NewPatch()
+	recordReadyTransition(b, true)
+	setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionFalse, "CreateBucketFailed", createErr.Error())
+	if err := r.Status().Patch(ctx, b, patch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket status conditions", "spec", b.Spec)
+	}
+
+	return ctrl.Result{}, createErr
+}
+
+// markCreated attaches the deletion finalizer and transitions b to
+// CreatedEvent, then schedules the next drift check if one is configured.
+func (r *S3BucketReconciler) markCreated(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket) (ctrl.Result, error) {
 	patch := b.NewPatch()
 	controllerutil.AddFinalizer(b, models.DeletionFinalizer)
 	b.Annotations[models.StateAnnotation] = models.CreatedEvent
-	err = r.Patch(ctx, b, patch)
-	if err != nil {
+	if err := r.Patch(ctx, b, patch); err != nil {
 		l.Error(err, "Unable to patch S3 bucket with deletion finalizer", "spec", b.Spec)
 		return ctrl.Result{}, err
 	}
 
+	statusPatch := b.NewPatch()
+	recordReadyTransition(b, false)
+	setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionTrue, "BucketCreated", "bucket exists and is reconciled")
+	setCondition(b, awsv1.ConditionTypeCredentialsValid, metav1.ConditionTrue, "CredentialsResolved", "credentials resolved successfully")
+	metrics.BucketsManaged.WithLabelValues(b.Spec.Region).Inc()
+	if err := r.Status().Patch(ctx, b, statusPatch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket status conditions", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
 	l.Info("Bucket successfully created", "spec", b.Spec)
 
-	return ctrl.Result{}, nil
+	return requeueForDrift(b), nil
 }
 
-func (r *S3BucketReconciler) handleSecret(ctx context.Context, b *awsv1.S3Bucket) (*v1.Secret, error) {
+// requeueForDrift schedules the next periodic drift check when
+// Spec.ReconcileInterval is configured, so a human editing the bucket
+// out-of-band in the AWS console gets reconciled away.
+func requeueForDrift(b *awsv1.S3Bucket) ctrl.Result {
+	if b.Spec.ReconcileInterval.Duration <= 0 {
+		return ctrl.Result{}
+	}
+
+	return ctrl.Result{RequeueAfter: b.Spec.ReconcileInterval.Duration}
+}
+
+// setCondition sets or updates a typed status condition on b's in-memory
+// copy, stamping ObservedGeneration so kubectl wait --for=condition=Ready
+// reflects the generation actually reconciled rather than a stale apply.
+// Callers still need to patch b's status for this to be persisted.
+func setCondition(b *awsv1.S3Bucket, condType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&b.Status.Conditions, metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		ObservedGeneration: b.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// recordReadyTransition adjusts BucketsInError by the delta between b's
+// current Ready condition and nowFailed, so the gauge reflects buckets
+// currently in error rather than growing every time the same bucket fails a
+// retry. Must be called before setCondition overwrites the Ready condition.
+func recordReadyTransition(b *awsv1.S3Bucket, nowFailed bool) {
+	wasFailed := meta.IsStatusConditionFalse(b.Status.Conditions, awsv1.ConditionTypeReady)
+	switch {
+	case nowFailed && !wasFailed:
+		metrics.BucketsInError.Inc()
+	case !nowFailed && wasFailed:
+		metrics.BucketsInError.Dec()
+	}
+}
+
+// handleDriftCheck re-verifies that the bucket still exists and, if it does,
+// re-applies any configuration a human changed out-of-band in the AWS
+// console. It only runs when Spec.ReconcileInterval is set; CreatedEvent and
+// UpdatedEvent are otherwise terminal states.
+func (r *S3BucketReconciler) handleDriftCheck(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket) (ctrl.Result, error) {
+	if b.Spec.ReconcileInterval.Duration <= 0 {
+		return ctrl.Result{}, nil
+	}
+
+	svc, err := buildS3Client(ctx, r.Client, b)
+	if err != nil {
+		l.Error(err, "Unable to build S3 client", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
+	headErr := metrics.TimedS3Call("HeadBucket", func() error {
+		_, err := svc.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	})
+	if headErr != nil {
+		l.Error(headErr, "Drift check: bucket is missing, recreating", "bucket", b.Spec.BucketName)
+
+		patch := b.NewPatch()
+		b.Annotations[models.StateAnnotation] = models.CreatingEvent
+		if err := r.Patch(ctx, b, patch); err != nil {
+			l.Error(err, "Unable to patch S3 bucket back to creating", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
+
+		statusPatch := b.NewPatch()
+		recordReadyTransition(b, true)
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionFalse, "BucketMissing", "bucket no longer exists, recreating")
+		if err := r.Status().Patch(ctx, b, statusPatch); err != nil {
+			l.Error(err, "Unable to patch S3 bucket status conditions", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
+
+		// An annotation-only patch does not bump Generation, so the update
+		// predicate filters it out; requeue to run handleCreate now.
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	conditions, failed := reconcileSubsystems(l, svc, b)
+
+	patch := b.NewPatch()
+	b.Status.SubsystemConditions = conditions
+	recordReadyTransition(b, failed)
+	if failed {
+		setCondition(b, awsv1.ConditionTypeDriftDetected, metav1.ConditionTrue, "SubsystemReconcileFailed", "one or more subsystems failed to reconcile, see status.subsystemConditions")
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionFalse, "SubsystemReconcileFailed", "one or more subsystems failed to reconcile")
+	} else {
+		setCondition(b, awsv1.ConditionTypeDriftDetected, metav1.ConditionFalse, "InSync", "no drift detected")
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionTrue, "InSync", "bucket configuration matches spec")
+	}
+	if err := r.Status().Patch(ctx, b, patch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket status", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
+	if failed {
+		return ctrl.Result{}, fmt.Errorf("drift check: one or more subsystems failed to reconcile for bucket %s, see status.subsystemConditions", b.Spec.BucketName)
+	}
+
+	l.Info("Drift check complete, bucket in sync", "bucket", b.Spec.BucketName)
+
+	return requeueForDrift(b), nil
+}
+
+func handleSecret(ctx context.Context, c client.Client, b *awsv1.S3Bucket) (*v1.Secret, error) {
 	secret := &v1.Secret{}
-	err := r.Get(ctx, types.NamespacedName{Name: b.Spec.AWSCredsSecretRef.Name, Namespace: b.Spec.AWSCredsSecretRef.Namespace}, secret)
+	err := c.Get(ctx, types.NamespacedName{Name: b.Spec.AWSCredsSecretRef.Name, Namespace: b.Spec.AWSCredsSecretRef.Namespace}, secret)
 	if err != nil {
 		return nil, err
 	}
@@ -170,36 +360,551 @@ func (r *S3BucketReconciler) handleSecret(ctx context.Context, b *awsv1.S3Bucket
 	return secret, nil
 }
 
-func (r *S3BucketReconciler) handleDelete(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket) (ctrl.Result, error) {
+// handleUpdate diffs every configured sub-spec against the live bucket and
+// applies whatever has drifted. Each subsystem is independent: a failure in
+// one (e.g. Policy) does not prevent the others from being applied, and is
+// instead recorded as a per-subsystem condition so the next requeue can
+// retry just the failing piece.
+func (r *S3BucketReconciler) handleUpdate(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket) (ctrl.Result, error) {
 	l.Info(
-		"Deleting bucket",
+		"Updating bucket",
 		"bucket name", b.Spec.BucketName,
 		"region", b.Spec.Region,
 	)
 
-	s, err := r.handleSecret(ctx, b)
+	svc, err := buildS3Client(ctx, r.Client, b)
 	if err != nil {
+		l.Error(err, "Unable to build S3 client", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
+	reconcilingPatch := b.NewPatch()
+	setCondition(b, awsv1.ConditionTypeReconciling, metav1.ConditionTrue, "SubsystemReconcileStarted", "applying configured sub-specs to the live bucket")
+	if err := r.Status().Patch(ctx, b, reconcilingPatch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket status conditions", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
+	conditions, failed := reconcileSubsystems(l, svc, b)
+
+	patch := b.NewPatch()
+	b.Status.SubsystemConditions = conditions
+	setCondition(b, awsv1.ConditionTypeReconciling, metav1.ConditionFalse, "SubsystemReconcileComplete", "finished applying configured sub-specs")
+	recordReadyTransition(b, failed)
+	if failed {
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionFalse, "SubsystemReconcileFailed", "one or more subsystems failed to reconcile")
+	} else {
+		setCondition(b, awsv1.ConditionTypeReady, metav1.ConditionTrue, "BucketUpdated", "bucket configuration matches spec")
+	}
+	if err := r.Status().Patch(ctx, b, patch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket status", "spec", b.Spec)
 		return ctrl.Result{}, err
 	}
 
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(b.Spec.Region),
-		Credentials: credentials.NewStaticCredentialsFromCreds(credentials.Value{
-			AccessKeyID:     string(s.Data["aws_access_key_id"]),
-			SecretAccessKey: string(s.Data["aws_secret_access_key"]),
-		}),
-	},
+	if failed {
+		return ctrl.Result{}, fmt.Errorf("one or more subsystems failed to reconcile for bucket %s, see status.subsystemConditions", b.Spec.BucketName)
+	}
+
+	patch = b.NewPatch()
+	b.Annotations[models.StateAnnotation] = models.UpdatedEvent
+	if err := r.Patch(ctx, b, patch); err != nil {
+		l.Error(err, "Unable to patch S3 bucket with updated state", "spec", b.Spec)
+		return ctrl.Result{}, err
+	}
+
+	l.Info("Bucket successfully updated", "spec", b.Spec)
+
+	return requeueForDrift(b), nil
+}
+
+// subsystemUpdaters lists every bucket sub-spec handleUpdate and the
+// periodic drift check diff against the live bucket.
+var subsystemUpdaters = []struct {
+	name   string
+	update func(*s3.S3, *awsv1.S3Bucket) error
+}{
+	{"Versioning", updateVersioning},
+	{"ServerSideEncryption", updateServerSideEncryption},
+	{"ACL", updateACL},
+	{"Policy", updatePolicy},
+	{"CORSRules", updateCORSRules},
+	{"LifecycleRules", updateLifecycleRules},
+	{"Tags", updateTags},
+	{"Website", updateWebsite},
+	{"Logging", updateLogging},
+}
+
+// reconcileSubsystems applies every configured sub-spec against the live
+// bucket and returns a condition per subsystem plus whether any of them failed.
+func reconcileSubsystems(l *logr.Logger, svc *s3.S3, b *awsv1.S3Bucket) ([]awsv1.SubsystemCondition, bool) {
+	conditions := make([]awsv1.SubsystemCondition, 0, len(subsystemUpdaters))
+	var failed bool
+	for _, sub := range subsystemUpdaters {
+		cond := awsv1.SubsystemCondition{
+			Subsystem:          sub.name,
+			Status:             "InSync",
+			LastTransitionTime: metav1.Now(),
+		}
+
+		if err := sub.update(svc, b); err != nil {
+			l.Error(err, "Unable to reconcile bucket subsystem", "subsystem", sub.name, "bucket", b.Spec.BucketName)
+			cond.Status = "Failed"
+			cond.Message = err.Error()
+			failed = true
+		}
+
+		for _, prev := range b.Status.SubsystemConditions {
+			if prev.Subsystem == cond.Subsystem && prev.Status == cond.Status {
+				cond.LastTransitionTime = prev.LastTransitionTime
+			}
+		}
+
+		conditions = append(conditions, cond)
+	}
+
+	return conditions, failed
+}
+
+func updateVersioning(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if b.Spec.Versioning == nil {
+		return nil
+	}
+
+	if !b.Spec.Versioning.Enabled && !b.Spec.Versioning.Suspended {
+		return nil
+	}
+
+	status := s3.BucketVersioningStatusSuspended
+	if b.Spec.Versioning.Enabled && !b.Spec.Versioning.Suspended {
+		status = s3.BucketVersioningStatusEnabled
+	}
+
+	mfaDelete := s3.MFADeleteStatusDisabled
+	if b.Spec.Versioning.MFADelete {
+		mfaDelete = s3.MFADeleteStatusEnabled
+	}
+
+	current, err := svc.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(current.Status) == status && aws.StringValue(current.MFADelete) == mfaDelete {
+		return nil
+	}
+
+	_, err = svc.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket: aws.String(b.Spec.BucketName),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status:    aws.String(status),
+			MFADelete: aws.String(mfaDelete),
+		},
+	})
+
+	return err
+}
+
+func updateServerSideEncryption(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if b.Spec.ServerSideEncryption == nil {
+		_, err := svc.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{Bucket: aws.String(b.Spec.BucketName)})
+		if err, ok := err.(interface{ Code() string }); ok && err.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+			return nil
+		}
+		return err
+	}
+
+	desired := &s3.ServerSideEncryptionConfiguration{
+		Rules: []*s3.ServerSideEncryptionRule{
+			{
+				ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+					SSEAlgorithm:   aws.String(b.Spec.ServerSideEncryption.SSEAlgorithm),
+					KMSMasterKeyID: aws.String(b.Spec.ServerSideEncryption.KMSMasterKeyID),
+				},
+				BucketKeyEnabled: aws.Bool(b.Spec.ServerSideEncryption.BucketKeyEnabled),
+			},
+		},
+	}
+
+	current, err := svc.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err != nil {
+		if awsErr, ok := err.(interface{ Code() string }); !ok || awsErr.Code() != "ServerSideEncryptionConfigurationNotFoundError" {
+			return err
+		}
+	} else if reflect.DeepEqual(current.ServerSideEncryptionConfiguration, desired) {
+		return nil
+	}
+
+	_, err = svc.PutBucketEncryption(&s3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(b.Spec.BucketName),
+		ServerSideEncryptionConfiguration: desired,
+	})
+
+	return err
+}
+
+// allUsersGranteeURI and authenticatedUsersGranteeURI are the well-known S3
+// group URIs used to recognize a canned ACL's grant shape in aclMatches.
+const (
+	allUsersGranteeURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersGranteeURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+func updateACL(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if b.Spec.ACL == "" {
+		return nil
+	}
+
+	current, err := svc.GetBucketAcl(&s3.GetBucketAclInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil && aclMatches(current, b.Spec.ACL) {
+		return nil
+	}
+
+	_, err = svc.PutBucketAcl(&s3.PutBucketAclInput{
+		Bucket: aws.String(b.Spec.BucketName),
+		ACL:    aws.String(b.Spec.ACL),
+	})
+
+	return err
+}
+
+// aclMatches reports whether current's grants already match the canned ACL
+// spec.acl requests. GetBucketAcl echoes back a grant list, not the canned
+// ACL name it was created from, so this only recognizes the grant shape of
+// the handful of canned ACLs with an unambiguous one; anything else (e.g.
+// log-delivery-write, a custom grant list) falls through to PutBucketAcl.
+func aclMatches(current *s3.GetBucketAclOutput, canned string) bool {
+	if current == nil || current.Owner == nil {
+		return false
+	}
+
+	ownerID := aws.StringValue(current.Owner.ID)
+	want, ok := map[string][]string{
+		"private":            {ownerID + ":FULL_CONTROL"},
+		"public-read":        {ownerID + ":FULL_CONTROL", allUsersGranteeURI + ":READ"},
+		"public-read-write":  {ownerID + ":FULL_CONTROL", allUsersGranteeURI + ":READ", allUsersGranteeURI + ":WRITE"},
+		"authenticated-read": {ownerID + ":FULL_CONTROL", authenticatedUsersGranteeURI + ":READ"},
+	}[canned]
+	if !ok {
+		return false
+	}
+
+	got := make([]string, 0, len(current.Grants))
+	for _, g := range current.Grants {
+		if g.Grantee == nil {
+			continue
+		}
+
+		id := aws.StringValue(g.Grantee.ID)
+		if id == "" {
+			id = aws.StringValue(g.Grantee.URI)
+		}
+
+		got = append(got, id+":"+aws.StringValue(g.Permission))
+	}
+
+	sort.Strings(got)
+	sort.Strings(want)
+
+	return reflect.DeepEqual(got, want)
+}
+
+// canonicalizeJSON re-marshals a JSON document through a generic structure so
+// that key ordering and whitespace differences between the spec's Policy
+// string and what AWS echoes back don't show up as drift.
+func canonicalizeJSON(raw string) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+func updatePolicy(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if b.Spec.Policy == "" {
+		_, err := svc.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	}
+
+	desired, err := canonicalizeJSON(b.Spec.Policy)
+	if err != nil {
+		return fmt.Errorf("spec.policy is not valid JSON: %w", err)
+	}
+
+	current, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil {
+		currentCanonical, err := canonicalizeJSON(aws.StringValue(current.Policy))
+		if err == nil && currentCanonical == desired {
+			return nil
+		}
+	}
+
+	_, err = svc.PutBucketPolicy(&s3.PutBucketPolicyInput{
+		Bucket: aws.String(b.Spec.BucketName),
+		Policy: aws.String(b.Spec.Policy),
+	})
+
+	return err
+}
+
+func updateCORSRules(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if len(b.Spec.CORSRules) == 0 {
+		_, err := svc.DeleteBucketCors(&s3.DeleteBucketCorsInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	}
+
+	rules := make([]*s3.CORSRule, 0, len(b.Spec.CORSRules))
+	for _, r := range b.Spec.CORSRules {
+		rules = append(rules, &s3.CORSRule{
+			ID:             aws.String(r.ID),
+			AllowedMethods: aws.StringSlice(r.AllowedMethods),
+			AllowedOrigins: aws.StringSlice(r.AllowedOrigins),
+			AllowedHeaders: aws.StringSlice(r.AllowedHeaders),
+			ExposeHeaders:  aws.StringSlice(r.ExposeHeaders),
+			MaxAgeSeconds:  aws.Int64(r.MaxAgeSeconds),
+		})
+	}
+
+	current, err := svc.GetBucketCors(&s3.GetBucketCorsInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil && reflect.DeepEqual(current.CORSRules, rules) {
+		return nil
+	}
+
+	_, err = svc.PutBucketCors(&s3.PutBucketCorsInput{
+		Bucket: aws.String(b.Spec.BucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: rules,
+		},
+	})
+
+	return err
+}
+
+func updateLifecycleRules(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if len(b.Spec.LifecycleRules) == 0 {
+		_, err := svc.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	}
+
+	rules := make([]*s3.LifecycleRule, 0, len(b.Spec.LifecycleRules))
+	for _, r := range b.Spec.LifecycleRules {
+		rule := &s3.LifecycleRule{
+			ID:     aws.String(r.ID),
+			Status: aws.String(r.Status),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(r.Filter.Prefix),
+			},
+		}
+
+		for _, t := range r.Transitions {
+			rule.Transitions = append(rule.Transitions, &s3.Transition{
+				Days:         aws.Int64(t.Days),
+				StorageClass: aws.String(t.StorageClass),
+			})
+		}
+
+		if r.ExpirationDays > 0 {
+			rule.Expiration = &s3.LifecycleExpiration{Days: aws.Int64(r.ExpirationDays)}
+		}
+
+		if r.AbortIncompleteMultipartUploadDays > 0 {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(r.AbortIncompleteMultipartUploadDays),
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	current, err := svc.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil && reflect.DeepEqual(current.Rules, rules) {
+		return nil
+	}
+
+	_, err = svc.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(b.Spec.BucketName),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	})
+
+	return err
+}
+
+func updateTags(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if len(b.Spec.Tags) == 0 {
+		_, err := svc.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	}
+
+	tagSet := make([]*s3.Tag, 0, len(b.Spec.Tags))
+	for k, v := range b.Spec.Tags {
+		tagSet = append(tagSet, &s3.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(tagSet, func(i, j int) bool { return *tagSet[i].Key < *tagSet[j].Key })
+
+	current, err := svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil {
+		currentSet := append([]*s3.Tag(nil), current.TagSet...)
+		sort.Slice(currentSet, func(i, j int) bool { return *currentSet[i].Key < *currentSet[j].Key })
+
+		if reflect.DeepEqual(currentSet, tagSet) {
+			return nil
+		}
+	}
+
+	_, err = svc.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket:  aws.String(b.Spec.BucketName),
+		Tagging: &s3.Tagging{TagSet: tagSet},
+	})
+
+	return err
+}
+
+func updateWebsite(svc *s3.S3, b *awsv1.S3Bucket) error {
+	if b.Spec.Website == nil {
+		_, err := svc.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
+	}
+
+	website := &s3.WebsiteConfiguration{}
+	if b.Spec.Website.RedirectAllRequestsTo != "" {
+		website.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(b.Spec.Website.RedirectAllRequestsTo)}
+	} else {
+		if b.Spec.Website.IndexDocument != "" {
+			website.IndexDocument = &s3.IndexDocument{Suffix: aws.String(b.Spec.Website.IndexDocument)}
+		}
+		if b.Spec.Website.ErrorDocument != "" {
+			website.ErrorDocument = &s3.ErrorDocument{Key: aws.String(b.Spec.Website.ErrorDocument)}
+		}
+	}
+
+	current, err := svc.GetBucketWebsite(&s3.GetBucketWebsiteInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil &&
+		reflect.DeepEqual(current.RedirectAllRequestsTo, website.RedirectAllRequestsTo) &&
+		reflect.DeepEqual(current.IndexDocument, website.IndexDocument) &&
+		reflect.DeepEqual(current.ErrorDocument, website.ErrorDocument) {
+		return nil
+	}
+
+	_, err = svc.PutBucketWebsite(&s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(b.Spec.BucketName),
+		WebsiteConfiguration: website,
+	})
+
+	return err
+}
+
+func updateLogging(svc *s3.S3, b *awsv1.S3Bucket) error {
+	logging := &s3.BucketLoggingStatus{}
+	if b.Spec.Logging != nil {
+		logging.LoggingEnabled = &s3.LoggingEnabled{
+			TargetBucket: aws.String(b.Spec.Logging.TargetBucket),
+			TargetPrefix: aws.String(b.Spec.Logging.TargetPrefix),
+		}
+	}
+
+	current, err := svc.GetBucketLogging(&s3.GetBucketLoggingInput{Bucket: aws.String(b.Spec.BucketName)})
+	if err == nil && reflect.DeepEqual(current.LoggingEnabled, logging.LoggingEnabled) {
+		return nil
+	}
+
+	_, err = svc.PutBucketLogging(&s3.PutBucketLoggingInput{
+		Bucket:              aws.String(b.Spec.BucketName),
+		BucketLoggingStatus: logging,
+	})
+
+	return err
+}
+
+func (r *S3BucketReconciler) handleDelete(ctx context.Context, l *logr.Logger, b *awsv1.S3Bucket) (ctrl.Result, error) {
+	l.Info(
+		"Deleting bucket",
+		"bucket name", b.Spec.BucketName,
+		"region", b.Spec.Region,
 	)
 
+	if !capabilitiesFor(b).SupportsDeleteBucket {
+		l.Info("Provider profile does not support DeleteBucket, only removing the finalizer",
+			"providerProfile", b.Spec.ProviderProfile, "bucket", b.Spec.BucketName)
+
+		patch := b.NewPatch()
+		controllerutil.RemoveFinalizer(b, models.DeletionFinalizer)
+		b.Annotations[models.StateAnnotation] = models.DeletedEvent
+		recordReadyTransition(b, false)
+		if err := r.Patch(ctx, b, patch); err != nil {
+			l.Error(err, "Unable to remove S3 Bucket finalizer", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
+		metrics.BucketsManaged.WithLabelValues(b.Spec.Region).Dec()
+
+		return ctrl.Result{}, nil
+	}
+
+	if b.Spec.DeletionPolicy == awsv1.DeletionPolicyRetain {
+		l.Info("DeletionPolicy is Retain, removing finalizer without touching the bucket", "bucket", b.Spec.BucketName)
+
+		patch := b.NewPatch()
+		controllerutil.RemoveFinalizer(b, models.DeletionFinalizer)
+		recordReadyTransition(b, false)
+		b.Annotations[models.StateAnnotation] = models.DeletedEvent
+		if err := r.Patch(ctx, b, patch); err != nil {
+			l.Error(err, "Unable to remove S3 Bucket finalizer", "spec", b.Spec)
+			return ctrl.Result{}, err
+		}
+		metrics.BucketsManaged.WithLabelValues(b.Spec.Region).Dec()
+
+		return ctrl.Result{}, nil
+	}
+
+	svc, err := buildS3Client(ctx, r.Client, b)
 	if err != nil {
-		l.Error(err, "Unable to create AWS session", "spec", b.Spec)
+		l.Error(err, "Unable to build S3 client", "spec", b.Spec)
 		return ctrl.Result{}, err
 	}
 
-	// Create S3 service client
-	svc := s3.New(sess)
-	_, err = svc.DeleteBucket(&s3.DeleteBucketInput{
-		Bucket: aws.String(b.Spec.BucketName),
+	if b.Spec.DeletionPolicy == awsv1.DeletionPolicyEmpty {
+		drained, deletedThisPass, err := emptyBucketPage(svc, b.Spec.BucketName)
+		if err != nil {
+			l.Error(err, "Unable to empty S3 bucket", "bucket", b.Spec.BucketName)
+			return ctrl.Result{}, err
+		}
+
+		if !drained {
+			l.Info("Emptying bucket", "bucket", b.Spec.BucketName, "deletedThisPass", deletedThisPass)
+
+			patch := b.NewPatch()
+			since := metav1.Now()
+			for _, prev := range b.Status.SubsystemConditions {
+				if prev.Subsystem == "Emptying" && prev.Status == "InProgress" {
+					since = prev.LastTransitionTime
+				}
+			}
+			b.Status.SubsystemConditions = []awsv1.SubsystemCondition{{
+				Subsystem:          "Emptying",
+				Status:             "InProgress",
+				Message:            fmt.Sprintf("deleted %d object versions this pass", deletedThisPass),
+				LastTransitionTime: since,
+			}}
+			if err := r.Status().Patch(ctx, b, patch); err != nil {
+				l.Error(err, "Unable to patch S3 bucket status", "spec", b.Spec)
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{RequeueAfter: emptyBucketRequeueDelay}, nil
+		}
+
+		l.Info("Bucket is now empty", "bucket", b.Spec.BucketName)
+	}
+
+	err = metrics.TimedS3Call("DeleteBucket", func() error {
+		_, err := svc.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(b.Spec.BucketName)})
+		return err
 	})
 	if err != nil {
 		l.Error(err, "Unable to delete S3 Bucket", "bucket", b.Spec)
@@ -209,8 +914,8 @@ func (r *S3BucketReconciler) handleDelete(ctx context.Context, l *logr.Logger, b
 	// Wait until bucket is created before finishing
 	l.Info("Waiting for bucket to be deleted...", "spec", b.Spec)
 
-	err = svc.WaitUntilBucketNotExists(&s3.HeadBucketInput{
-		Bucket: aws.String(b.Spec.BucketName),
+	err = metrics.TimedS3Call("WaitUntilBucketNotExists", func() error {
+		return svc.WaitUntilBucketNotExists(&s3.HeadBucketInput{Bucket: aws.String(b.Spec.BucketName)})
 	})
 	if err != nil {
 		l.Error(err, "Unable to wait for bucket to be deleted", "spec", b.Spec)
@@ -220,17 +925,65 @@ func (r *S3BucketReconciler) handleDelete(ctx context.Context, l *logr.Logger, b
 	patch := b.NewPatch()
 	controllerutil.RemoveFinalizer(b, models.DeletionFinalizer)
 	b.Annotations[models.StateAnnotation] = models.DeletedEvent
+	recordReadyTransition(b, false)
 	err = r.Patch(ctx, b, patch)
 	if err != nil {
 		l.Error(err, "Unable to remove S3 Bucket finalizer", "spec", b.Spec)
 		return ctrl.Result{}, err
 	}
 
+	metrics.BucketsManaged.WithLabelValues(b.Spec.Region).Dec()
 	l.Info("Bucket successfully deleted", "spec", b.Spec)
 
 	return ctrl.Result{}, nil
 }
 
+// emptyBucketPage deletes up to one batch (max 1000 keys) of object versions
+// and delete markers. It returns drained=true once ListObjectVersions comes
+// back with nothing left, signalling the bucket is ready for DeleteBucket.
+// Callers are expected to call this repeatedly across reconciles (via
+// requeue) rather than loop internally, so a huge bucket doesn't block the
+// worker goroutine for the whole emptying process.
+func emptyBucketPage(svc s3iface.S3API, bucket string) (drained bool, deletedThisPass int, err error) {
+	var out *s3.ListObjectVersionsOutput
+	err = metrics.TimedS3Call("ListObjectVersions", func() error {
+		var err error
+		out, err = svc.ListObjectVersions(&s3.ListObjectVersionsInput{
+			Bucket:  aws.String(bucket),
+			MaxKeys: aws.Int64(1000),
+		})
+		return err
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	toDelete := make([]*s3.ObjectIdentifier, 0, len(out.Versions)+len(out.DeleteMarkers))
+	for _, v := range out.Versions {
+		toDelete = append(toDelete, &s3.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range out.DeleteMarkers {
+		toDelete = append(toDelete, &s3.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+
+	if len(toDelete) == 0 {
+		return true, 0, nil
+	}
+
+	err = metrics.TimedS3Call("DeleteObjects", func() error {
+		_, err := svc.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: toDelete, Quiet: aws.Bool(true)},
+		})
+		return err
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	return false, len(toDelete), nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *S3BucketReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	awsv1 "github.com/testisnullus/KubeS3/api/v1"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/testisnullus/KubeS3/internal/metrics"
+)
+
+func TestRecordReadyTransition(t *testing.T) {
+	newReady := func() *awsv1.S3Bucket {
+		b := &awsv1.S3Bucket{}
+		setCondition(b, awsv1.ConditionTypeReady, "True", "InSync", "bucket configuration matches spec")
+		return b
+	}
+	newFailed := func() *awsv1.S3Bucket {
+		b := &awsv1.S3Bucket{}
+		setCondition(b, awsv1.ConditionTypeReady, "False", "SubsystemReconcileFailed", "one or more subsystems failed to reconcile")
+		return b
+	}
+
+	before := testutil.ToFloat64(metrics.BucketsInError)
+
+	recordReadyTransition(newReady(), true)
+	if got := testutil.ToFloat64(metrics.BucketsInError); got != before+1 {
+		t.Errorf("Ready -> failed: BucketsInError = %v, want %v", got, before+1)
+	}
+
+	recordReadyTransition(newFailed(), false)
+	if got := testutil.ToFloat64(metrics.BucketsInError); got != before {
+		t.Errorf("failed -> Ready: BucketsInError = %v, want %v", got, before)
+	}
+
+	recordReadyTransition(newFailed(), true)
+	if got := testutil.ToFloat64(metrics.BucketsInError); got != before {
+		t.Errorf("failed -> failed: BucketsInError = %v, want unchanged %v", got, before)
+	}
+
+	recordReadyTransition(newReady(), false)
+	if got := testutil.ToFloat64(metrics.BucketsInError); got != before {
+		t.Errorf("Ready -> Ready: BucketsInError = %v, want unchanged %v", got, before)
+	}
+}
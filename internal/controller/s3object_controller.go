@@ -0,0 +1,413 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	awsv1 "github.com/testisnullus/KubeS3/api/v1"
+	"github.com/testisnullus/KubeS3/internal/metrics"
+	"github.com/testisnullus/KubeS3/internal/models"
+	"github.com/testisnullus/KubeS3/internal/ratelimiter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// shaMetadataKey is stored as object user metadata so subsequent reconciles
+// can tell, via a single HEAD, whether the desired content is already in
+// place without re-reading or re-uploading it. AWS delivers it back over the
+// wire as the "x-amz-meta-kubes3-sha256" header.
+const shaMetadataKey = "Kubes3-Sha256"
+
+// S3ObjectReconciler reconciles a S3Object object
+type S3ObjectReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=aws.nullzen.ai,resources=s3objects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws.nullzen.ai,resources=s3objects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws.nullzen.ai,resources=s3objects/finalizers,verbs=update
+// +kubebuilder:rbac:groups=aws.nullzen.ai,resources=s3buckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// Reconcile moves the current state of an S3Object closer to its desired state.
+func (r *S3ObjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+
+	o := &awsv1.S3Object{}
+	err := r.Client.Get(ctx, req.NamespacedName, o)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("S3 object resource is not found",
+				"request", req)
+			return ctrl.Result{}, nil
+		}
+
+		l.Error(err, "Unable to fetch S3 object",
+			"request", req)
+		return ctrl.Result{}, err
+	}
+
+	switch o.Annotations[models.StateAnnotation] {
+	case models.CreatingEvent:
+		l = l.WithName("S3 Object creation")
+		return r.handleCreate(ctx, &l, o)
+	case models.UpdatingEvent:
+		l = l.WithName("S3 Object update")
+		return r.handleUpdate(ctx, &l, o)
+	case models.DeletingEvent:
+		l = l.WithName("S3 Object deleting")
+		return r.handleDelete(ctx, &l, o)
+	case models.GenericEvent:
+		l = l.WithName("S3 Object generic")
+		l.Info("Event isn't handled",
+			"request", req,
+			"key", o.Spec.Key,
+			"event", o.Annotations[models.StateAnnotation])
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *S3ObjectReconciler) handleCreate(ctx context.Context, l *logr.Logger, o *awsv1.S3Object) (ctrl.Result, error) {
+	l.Info("Creating object", "bucketRef", o.Spec.BucketRef, "key", o.Spec.Key)
+
+	sha, err := r.put(ctx, l, o)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	patch := o.NewPatch()
+	controllerutil.AddFinalizer(o, models.DeletionFinalizer)
+	o.Annotations[models.StateAnnotation] = models.CreatedEvent
+	if err := r.Patch(ctx, o, patch); err != nil {
+		l.Error(err, "Unable to patch S3 object with deletion finalizer", "spec", o.Spec)
+		return ctrl.Result{}, err
+	}
+
+	patch = o.NewPatch()
+	o.Status.SHA256 = sha
+	if err := r.Status().Patch(ctx, o, patch); err != nil {
+		l.Error(err, "Unable to patch S3 object status", "spec", o.Spec)
+		return ctrl.Result{}, err
+	}
+
+	l.Info("Object successfully created", "spec", o.Spec)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *S3ObjectReconciler) handleUpdate(ctx context.Context, l *logr.Logger, o *awsv1.S3Object) (ctrl.Result, error) {
+	l.Info("Updating object", "bucketRef", o.Spec.BucketRef, "key", o.Spec.Key)
+
+	sha, err := r.put(ctx, l, o)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	patch := o.NewPatch()
+	o.Annotations[models.StateAnnotation] = models.UpdatedEvent
+	if err := r.Patch(ctx, o, patch); err != nil {
+		l.Error(err, "Unable to patch S3 object with updated state", "spec", o.Spec)
+		return ctrl.Result{}, err
+	}
+
+	patch = o.NewPatch()
+	o.Status.SHA256 = sha
+	if err := r.Status().Patch(ctx, o, patch); err != nil {
+		l.Error(err, "Unable to patch S3 object status", "spec", o.Spec)
+		return ctrl.Result{}, err
+	}
+
+	l.Info("Object successfully updated", "spec", o.Spec)
+
+	return ctrl.Result{}, nil
+}
+
+// put resolves the desired content, HEADs the live object to see whether it
+// already carries the matching shaMetadataKey, and PUTs only when it
+// doesn't. It returns the hex-encoded SHA-256 of the content that is now (or
+// already was) in place.
+func (r *S3ObjectReconciler) put(ctx context.Context, l *logr.Logger, o *awsv1.S3Object) (string, error) {
+	content, err := resolveContent(ctx, r.Client, o)
+	if err != nil {
+		l.Error(err, "Unable to resolve object content", "spec", o.Spec)
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	sha := hex.EncodeToString(sum[:])
+
+	bucket, svc, err := r.bucketClient(ctx, o)
+	if err != nil {
+		l.Error(err, "Unable to build S3 client for referenced bucket", "bucketRef", o.Spec.BucketRef)
+		return "", err
+	}
+
+	var head *s3.HeadObjectOutput
+	err = metrics.TimedS3Call("HeadObject", func() error {
+		var err error
+		head, err = svc.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket.Spec.BucketName),
+			Key:    aws.String(o.Spec.Key),
+		})
+		return err
+	})
+	if err == nil && aws.StringValue(head.Metadata[shaMetadataKey]) == sha {
+		l.Info("Object already matches desired content, skipping PUT", "key", o.Spec.Key)
+		return sha, nil
+	}
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "NotFound" {
+			return "", err
+		}
+	}
+
+	metadata := make(map[string]*string, len(o.Spec.Metadata)+1)
+	for k, v := range o.Spec.Metadata {
+		metadata[k] = aws.String(v)
+	}
+	metadata[shaMetadataKey] = aws.String(sha)
+
+	input := &s3.PutObjectInput{
+		Bucket:   aws.String(bucket.Spec.BucketName),
+		Key:      aws.String(o.Spec.Key),
+		Body:     bytes.NewReader(content),
+		Metadata: metadata,
+	}
+
+	if o.Spec.ContentType != "" {
+		input.ContentType = aws.String(o.Spec.ContentType)
+	}
+	if o.Spec.CacheControl != "" {
+		input.CacheControl = aws.String(o.Spec.CacheControl)
+	}
+	if o.Spec.StorageClass != "" {
+		input.StorageClass = aws.String(o.Spec.StorageClass)
+	}
+	if o.Spec.SSE != nil {
+		input.ServerSideEncryption = aws.String(o.Spec.SSE.SSEAlgorithm)
+		if o.Spec.SSE.KMSMasterKeyID != "" {
+			input.SSEKMSKeyId = aws.String(o.Spec.SSE.KMSMasterKeyID)
+		}
+	}
+	if len(o.Spec.Tags) > 0 {
+		tagging := url.Values{}
+		for k, v := range o.Spec.Tags {
+			tagging.Set(k, v)
+		}
+		input.Tagging = aws.String(tagging.Encode())
+	}
+
+	if err := metrics.TimedS3Call("PutObject", func() error {
+		_, err := svc.PutObject(input)
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	return sha, nil
+}
+
+// bucketClient fetches the S3Bucket named by o.Spec.BucketRef and builds an
+// S3 client for it, so an S3Object reconciles through the same credential
+// resolution and endpoint overrides as its bucket.
+func (r *S3ObjectReconciler) bucketClient(ctx context.Context, o *awsv1.S3Object) (*awsv1.S3Bucket, *s3.S3, error) {
+	ns := o.Spec.BucketRef.Namespace
+	if ns == "" {
+		ns = o.Namespace
+	}
+
+	bucket := &awsv1.S3Bucket{}
+	if err := r.Get(ctx, types.NamespacedName{Name: o.Spec.BucketRef.Name, Namespace: ns}, bucket); err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch referenced S3Bucket %s/%s: %w", ns, o.Spec.BucketRef.Name, err)
+	}
+
+	svc, err := buildS3Client(ctx, r.Client, bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bucket, svc, nil
+}
+
+// resolveContent reads the object's desired content from whichever of
+// InlineData, SecretRef, ConfigMapRef, or SourceURL is set.
+func resolveContent(ctx context.Context, c client.Client, o *awsv1.S3Object) ([]byte, error) {
+	switch {
+	case o.Spec.InlineData != nil:
+		return o.Spec.InlineData, nil
+
+	case o.Spec.SecretRef != nil:
+		ref := o.Spec.SecretRef
+		ns := ref.Namespace
+		if ns == "" {
+			ns = o.Namespace
+		}
+
+		secret := &v1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ns}, secret); err != nil {
+			return nil, err
+		}
+
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s has no key %q", ns, ref.Name, ref.Key)
+		}
+
+		return data, nil
+
+	case o.Spec.ConfigMapRef != nil:
+		ref := o.Spec.ConfigMapRef
+		ns := ref.Namespace
+		if ns == "" {
+			ns = o.Namespace
+		}
+
+		cm := &v1.ConfigMap{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ns}, cm); err != nil {
+			return nil, err
+		}
+
+		if data, ok := cm.BinaryData[ref.Key]; ok {
+			return data, nil
+		}
+		if data, ok := cm.Data[ref.Key]; ok {
+			return []byte(data), nil
+		}
+
+		return nil, fmt.Errorf("configmap %s/%s has no key %q", ns, ref.Name, ref.Key)
+
+	case o.Spec.SourceURL != "":
+		resp, err := http.Get(o.Spec.SourceURL)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching %s: unexpected status %s", o.Spec.SourceURL, resp.Status)
+		}
+
+		return io.ReadAll(resp.Body)
+
+	default:
+		return nil, fmt.Errorf("s3object %s/%s sets none of inlineData, secretRef, configMapRef, sourceURL", o.Namespace, o.Name)
+	}
+}
+
+func (r *S3ObjectReconciler) handleDelete(ctx context.Context, l *logr.Logger, o *awsv1.S3Object) (ctrl.Result, error) {
+	l.Info("Deleting object", "bucketRef", o.Spec.BucketRef, "key", o.Spec.Key)
+
+	bucket, svc, err := r.bucketClient(ctx, o)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			l.Info("Referenced S3Bucket is already gone, dropping finalizer", "bucketRef", o.Spec.BucketRef)
+		} else {
+			l.Error(err, "Unable to build S3 client for referenced bucket", "bucketRef", o.Spec.BucketRef)
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = metrics.TimedS3Call("DeleteObject", func() error {
+			_, err := svc.DeleteObject(&s3.DeleteObjectInput{
+				Bucket: aws.String(bucket.Spec.BucketName),
+				Key:    aws.String(o.Spec.Key),
+			})
+			return err
+		})
+		if err != nil {
+			l.Error(err, "Unable to delete S3 object", "spec", o.Spec)
+			return ctrl.Result{}, err
+		}
+	}
+
+	patch := o.NewPatch()
+	controllerutil.RemoveFinalizer(o, models.DeletionFinalizer)
+	o.Annotations[models.StateAnnotation] = models.DeletedEvent
+	if err := r.Patch(ctx, o, patch); err != nil {
+		l.Error(err, "Unable to remove S3 object finalizer", "spec", o.Spec)
+		return ctrl.Result{}, err
+	}
+
+	l.Info("Object successfully deleted", "spec", o.Spec)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *S3ObjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&awsv1.S3Object{}, builder.WithPredicates(predicate.Funcs{
+			CreateFunc: func(event event.CreateEvent) bool {
+				if event.Object.GetDeletionTimestamp() != nil {
+					event.Object.GetAnnotations()[models.StateAnnotation] = models.DeletingEvent
+					return true
+				}
+
+				event.Object.GetAnnotations()[models.StateAnnotation] = models.CreatingEvent
+				return true
+			},
+			UpdateFunc: func(event event.UpdateEvent) bool {
+				newObj := event.ObjectNew.(*awsv1.S3Object)
+				if newObj.Generation == event.ObjectOld.GetGeneration() {
+					return false
+				}
+
+				if newObj.DeletionTimestamp != nil {
+					event.ObjectNew.GetAnnotations()[models.StateAnnotation] = models.DeletingEvent
+					return true
+				}
+
+				newObj.Annotations[models.StateAnnotation] = models.UpdatingEvent
+				return true
+			},
+			GenericFunc: func(genericEvent event.GenericEvent) bool {
+				genericEvent.Object.GetAnnotations()[models.StateAnnotation] = models.GenericEvent
+				return true
+			},
+		})).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewItemExponentialFailureRateLimiterWithMaxTries(ratelimiter.DefaultBaseDelay, ratelimiter.DefaultMaxDelay),
+		}).
+		Named("s3object").
+		Complete(r)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the Prometheus metrics exported by the KubeS3
+// operator and registers them with controller-runtime's metrics registry, so
+// they show up on the manager's existing /metrics endpoint alongside the
+// controller-runtime-provided reconcile metrics.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// ReconcileTotal counts every Reconcile call, by outcome and by the
+	// StateAnnotation event that triggered it.
+	ReconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubes3_reconcile_total",
+		Help: "Total number of reconciles, by result and triggering event.",
+	}, []string{"result", "event"})
+
+	// S3APICallsTotal counts every S3 API call the operator makes, by
+	// operation and by whether it succeeded.
+	S3APICallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubes3_s3_api_calls_total",
+		Help: "Total number of S3 API calls made by the operator, by operation and result.",
+	}, []string{"op", "result"})
+
+	// S3APILatencySeconds observes how long each S3 API call takes, by operation.
+	S3APILatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kubes3_s3_api_latency_seconds",
+		Help:    "Latency of S3 API calls made by the operator, by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"op"})
+
+	// BucketsManaged reports the number of buckets this operator currently
+	// owns, by region.
+	BucketsManaged = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubes3_buckets_managed",
+		Help: "Number of S3Bucket resources currently managed, by region.",
+	}, []string{"region"})
+
+	// BucketsInError reports the number of buckets whose most recent
+	// reconcile ended in an error.
+	BucketsInError = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "kubes3_buckets_in_error",
+		Help: "Number of S3Bucket resources whose last reconcile ended in an error.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ReconcileTotal,
+		S3APICallsTotal,
+		S3APILatencySeconds,
+		BucketsManaged,
+		BucketsInError,
+	)
+}
+
+// TimedS3Call invokes fn, recording its result in S3APICallsTotal and its
+// duration in S3APILatencySeconds under op, the S3 API operation name (e.g.
+// "CreateBucket"). It returns whatever error fn returns, so callers can wrap
+// an existing call in place without changing their error handling.
+func TimedS3Call(op string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	S3APILatencySeconds.WithLabelValues(op).Observe(time.Since(start).Seconds())
+
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	S3APICallsTotal.WithLabelValues(op, result).Inc()
+
+	return err
+}
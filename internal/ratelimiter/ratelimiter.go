@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimiter provides the shared work-queue rate limiter used by
+// every reconciler in this operator.
+package ratelimiter
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DefaultBaseDelay is the initial requeue delay after a reconcile failure.
+	DefaultBaseDelay = 5 * time.Second
+
+	// DefaultMaxDelay caps how long a failing item backs off before retrying.
+	DefaultMaxDelay = 15 * time.Minute
+
+	// DefaultMaxTries is not read by NewItemExponentialFailureRateLimiterWithMaxTries,
+	// which places no cap on the number of attempts.
+	DefaultMaxTries = 15
+)
+
+// NewItemExponentialFailureRateLimiterWithMaxTries builds a per-item
+// exponential backoff rate limiter bounded by baseDelay and maxDelay, typed
+// for use as a controller.Options.RateLimiter. Once the backoff reaches
+// maxDelay a failing item keeps retrying at maxDelay; the number of tries is
+// not limited.
+func NewItemExponentialFailureRateLimiterWithMaxTries(baseDelay, maxDelay time.Duration) workqueue.TypedRateLimiter[reconcile.Request] {
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay)
+}